@@ -0,0 +1,187 @@
+package week
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Predeclared layouts for use with Format, Parse and ParseWeekDate.
+const (
+	LayoutISOShort    = "%G-W%V"
+	LayoutISOExtended = "%G-W%V-%u"
+)
+
+// Format renders w according to layout, a strftime-like pattern supporting %G, %g, %V
+// and the literal escape %%. %u is rejected, since a plain Week carries no weekday; use
+// WeekDate.Format for that.
+func (w Week) Format(layout string) (string, error) {
+	return formatLayout(layout, w.year, w.week, 0)
+}
+
+// Format renders d according to layout, a strftime-like pattern supporting %G, %g, %V,
+// %u and the literal escape %%.
+func (d WeekDate) Format(layout string) (string, error) {
+	return formatLayout(layout, d.week.year, d.week.week, d.weekday)
+}
+
+// Parse parses value according to layout and returns the resulting Week. Any %u token
+// in layout is parsed but discarded; use ParseWeekDate to keep it.
+func Parse(layout, value string) (Week, error) {
+	year, week, _, err := parseLayout(layout, value)
+	if err != nil {
+		return Week{}, errors.Wrap(err, "unable to parse week")
+	}
+
+	return New(year, week)
+}
+
+// ParseWeekDate parses value according to layout and returns the resulting WeekDate.
+// layout must contain a %u token.
+func ParseWeekDate(layout, value string) (WeekDate, error) {
+	year, week, weekday, err := parseLayout(layout, value)
+	if err != nil {
+		return WeekDate{}, errors.Wrap(err, "unable to parse week date")
+	}
+
+	if weekday == 0 {
+		return WeekDate{}, errors.New("unable to parse week date: layout has no %u token")
+	}
+
+	w, err := New(year, week)
+	if err != nil {
+		return WeekDate{}, err
+	}
+
+	return WeekDate{week: w, weekday: weekday}, nil
+}
+
+// formatLayout renders year, week and, if non-zero, weekday according to layout.
+func formatLayout(layout string, year, week, weekday int) (string, error) {
+	buf := make([]byte, 0, len(layout))
+
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			buf = append(buf, layout[i])
+			continue
+		}
+
+		i++
+		if i >= len(layout) {
+			return "", errors.New("invalid layout: trailing %")
+		}
+
+		switch layout[i] {
+		case 'G':
+			buf = appendDigits(buf, year, 4)
+		case 'g':
+			buf = appendDigits(buf, ((year%100)+100)%100, 2)
+		case 'V':
+			buf = appendDigits(buf, week, 2)
+		case 'u':
+			if weekday == 0 {
+				return "", errors.New("layout %u requires a weekday; use WeekDate.Format")
+			}
+			buf = appendDigits(buf, weekday, 1)
+		case '%':
+			buf = append(buf, '%')
+		default:
+			return "", errors.Errorf("unknown layout token %%%c", layout[i])
+		}
+	}
+
+	return string(buf), nil
+}
+
+// parseLayout parses value according to layout, returning the ISO year, week and, if
+// layout contains %u, weekday (otherwise 0). It validates that value is consumed
+// exactly and reuses checkYearAndWeek to validate the result.
+func parseLayout(layout, value string) (year, week, weekday int, err error) {
+	var (
+		yearSet, weekSet bool
+		twoDigitYear     = -1
+		i, j             int
+	)
+
+	for i < len(layout) {
+		if layout[i] != '%' {
+			if j >= len(value) || value[j] != layout[i] {
+				return 0, 0, 0, errors.Errorf("value %q does not match layout %q", value, layout)
+			}
+			i++
+			j++
+			continue
+		}
+
+		i++
+		if i >= len(layout) {
+			return 0, 0, 0, errors.New("invalid layout: trailing %")
+		}
+
+		width := 0
+		switch layout[i] {
+		case 'G':
+			width = 4
+		case 'g':
+			width = 2
+		case 'V':
+			width = 2
+		case 'u':
+			width = 1
+		case '%':
+			if j >= len(value) || value[j] != '%' {
+				return 0, 0, 0, errors.Errorf("value %q does not match layout %q", value, layout)
+			}
+			i++
+			j++
+			continue
+		default:
+			return 0, 0, 0, errors.Errorf("unknown layout token %%%c", layout[i])
+		}
+
+		if j+width > len(value) {
+			return 0, 0, 0, errors.Errorf("value %q is too short for layout %q", value, layout)
+		}
+
+		n, parseErr := parseDigits([]byte(value[j : j+width]))
+		if parseErr != nil {
+			return 0, 0, 0, errors.Wrapf(parseErr, "invalid value %q for layout %q", value, layout)
+		}
+
+		switch layout[i] {
+		case 'G':
+			year, yearSet = n, true
+		case 'g':
+			twoDigitYear = n
+		case 'V':
+			week, weekSet = n, true
+		case 'u':
+			if n < 1 || n > 7 {
+				return 0, 0, 0, errors.Errorf("weekday %d out of range [1, 7]", n)
+			}
+			weekday = n
+		}
+
+		i++
+		j += width
+	}
+
+	if j != len(value) {
+		return 0, 0, 0, errors.Errorf("value %q has trailing content not consumed by layout %q", value, layout)
+	}
+
+	if !yearSet {
+		if twoDigitYear == -1 {
+			return 0, 0, 0, errors.New("layout must contain %G or %g")
+		}
+		year = 2000 + twoDigitYear
+	}
+
+	if !weekSet {
+		return 0, 0, 0, errors.New("layout must contain %V")
+	}
+
+	if err = checkYearAndWeek(year, week); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return year, week, weekday, nil
+}
@@ -0,0 +1,137 @@
+package week
+
+import "testing"
+
+func TestWeekFormat(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		layout string
+		want   string
+	}{
+		{LayoutISOShort, "2024-W07"},
+		{"%G%V", "202407"},
+		{"%g-W%V", "24-W07"},
+		{"literal %%G %G", "literal %G 2024"},
+	}
+
+	for _, c := range cases {
+		got, err := w.Format(c.layout)
+		if err != nil {
+			t.Fatalf("Format(%q): %v", c.layout, err)
+		}
+		if got != c.want {
+			t.Errorf("Format(%q) got %q want %q", c.layout, got, c.want)
+		}
+	}
+}
+
+func TestWeekFormatRejectsWeekday(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := w.Format(LayoutISOExtended); err == nil {
+		t.Error("expected error formatting %u from a plain Week")
+	}
+}
+
+func TestWeekDateFormat(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd := w.On(3) // time.Wednesday
+
+	got, err := wd.Format(LayoutISOExtended)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "2024-W07-3" {
+		t.Errorf("got %q want %q", got, "2024-W07-3")
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		layout, value string
+		year, week    int
+	}{
+		{LayoutISOShort, "2024-W07", 2024, 7},
+		{"%G%V", "202407", 2024, 7},
+		{"%g-W%V", "24-W07", 2024, 7},
+	}
+
+	for _, c := range cases {
+		got, err := Parse(c.layout, c.value)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q): %v", c.layout, c.value, err)
+		}
+		want, err := New(c.year, c.week)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Parse(%q, %q) got %+v want %+v", c.layout, c.value, got, want)
+		}
+	}
+}
+
+func TestParseRejectsTrailingContent(t *testing.T) {
+	if _, err := Parse(LayoutISOShort, "2024-W07-3"); err == nil {
+		t.Error("expected error for trailing content not consumed by layout")
+	}
+}
+
+func TestParseRejectsMismatch(t *testing.T) {
+	if _, err := Parse(LayoutISOShort, "2024/W07"); err == nil {
+		t.Error("expected error for value not matching layout literals")
+	}
+}
+
+func TestParseWeekDate(t *testing.T) {
+	got, err := ParseWeekDate(LayoutISOExtended, "2024-W07-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := w.On(3)
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestParseWeekDateRequiresWeekdayToken(t *testing.T) {
+	if _, err := ParseWeekDate(LayoutISOShort, "2024-W07"); err == nil {
+		t.Error("expected error parsing WeekDate from a layout without %u")
+	}
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, layout := range []string{LayoutISOShort, "%G%V", "%g-W%V"} {
+		s, err := w.Format(layout)
+		if err != nil {
+			t.Fatalf("Format(%q): %v", layout, err)
+		}
+		got, err := Parse(layout, s)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q): %v", layout, s, err)
+		}
+		if got != w {
+			t.Errorf("round trip via %q got %+v want %+v", layout, got, w)
+		}
+	}
+}
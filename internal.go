@@ -0,0 +1,138 @@
+package week
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// shortFormLen is the length of the short ISO week date form, e.g. "2024-W07".
+// extendedFormLen is the length of the extended form with a weekday component,
+// e.g. "2024-W07-3".
+const (
+	shortFormLen    = 8
+	extendedFormLen = 10
+)
+
+// isLeapYear reports whether year is a leap year in the proleptic Gregorian calendar.
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// weeksInYear returns the number of ISO weeks (52 or 53) in the given ISO week-numbering year.
+func weeksInYear(year int) int {
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	if jan1 == time.Thursday || (jan1 == time.Wednesday && isLeapYear(year)) {
+		return 53
+	}
+	return 52
+}
+
+// checkYearAndWeek validates that year and week together form a valid ISO week date.
+func checkYearAndWeek(year, week int) error {
+	if year < 0 || year > 9999 {
+		return errors.Errorf("year %d out of range [0, 9999]", year)
+	}
+
+	max := weeksInYear(year)
+	if week < 1 || week > max {
+		return errors.Errorf("week %d out of range [1, %d] for year %d", week, max, year)
+	}
+
+	return nil
+}
+
+// decodeISOWeekDate parses an ISO 8601 week date in either the short form (YYYY-Www) or
+// the extended form with a weekday component (YYYY-Www-D, D being 1..7). weekday is
+// returned as 0 when the short form was used.
+func decodeISOWeekDate(data []byte) (year, week, weekday int, err error) {
+	switch len(data) {
+	case shortFormLen, extendedFormLen:
+	default:
+		return 0, 0, 0, errors.Errorf("invalid ISO week date %q: unexpected length", data)
+	}
+
+	if data[4] != '-' || data[5] != 'W' {
+		return 0, 0, 0, errors.Errorf("invalid ISO week date %q: expected \"-W\" at position 4", data)
+	}
+
+	year, err = parseDigits(data[0:4])
+	if err != nil {
+		return 0, 0, 0, errors.Wrap(err, "invalid ISO week date: year")
+	}
+
+	week, err = parseDigits(data[6:8])
+	if err != nil {
+		return 0, 0, 0, errors.Wrap(err, "invalid ISO week date: week")
+	}
+
+	if len(data) == extendedFormLen {
+		if data[8] != '-' {
+			return 0, 0, 0, errors.Errorf("invalid ISO week date %q: expected \"-\" at position 8", data)
+		}
+
+		weekday, err = parseDigits(data[9:10])
+		if err != nil {
+			return 0, 0, 0, errors.Wrap(err, "invalid ISO week date: weekday")
+		}
+
+		if weekday < 1 || weekday > 7 {
+			return 0, 0, 0, errors.Errorf("invalid ISO week date %q: weekday %d out of range [1, 7]", data, weekday)
+		}
+	}
+
+	if err = checkYearAndWeek(year, week); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return year, week, weekday, nil
+}
+
+// encodeISOWeekDate renders year and week in the short ISO form (YYYY-Www), or in the
+// extended form with a weekday component (YYYY-Www-D) when weekday is non-zero.
+func encodeISOWeekDate(year, week, weekday int) ([]byte, error) {
+	if err := checkYearAndWeek(year, week); err != nil {
+		return nil, err
+	}
+
+	if weekday != 0 && (weekday < 1 || weekday > 7) {
+		return nil, errors.Errorf("weekday %d out of range [1, 7]", weekday)
+	}
+
+	buf := make([]byte, 0, extendedFormLen)
+	buf = appendDigits(buf, year, 4)
+	buf = append(buf, '-', 'W')
+	buf = appendDigits(buf, week, 2)
+
+	if weekday != 0 {
+		buf = append(buf, '-')
+		buf = appendDigits(buf, weekday, 1)
+	}
+
+	return buf, nil
+}
+
+// parseDigits parses an unsigned decimal integer from data, rejecting any non-digit byte.
+func parseDigits(data []byte) (int, error) {
+	n := 0
+	for _, b := range data {
+		if b < '0' || b > '9' {
+			return 0, errors.Errorf("invalid digit %q", b)
+		}
+		n = n*10 + int(b-'0')
+	}
+	return n, nil
+}
+
+// appendDigits appends n to buf as a zero-padded decimal number of the given width.
+func appendDigits(buf []byte, n, width int) []byte {
+	start := len(buf)
+	for i := 0; i < width; i++ {
+		buf = append(buf, '0')
+	}
+	for i := width - 1; i >= 0 && n > 0; i-- {
+		buf[start+i] = byte('0' + n%10)
+		n /= 10
+	}
+	return buf
+}
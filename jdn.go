@@ -0,0 +1,43 @@
+package week
+
+// julianDayNumber converts a proleptic Gregorian calendar date into its Julian Day
+// Number, using the closed-form conversion described at
+// https://en.wikipedia.org/wiki/Julian_day#Julian_day_number_calculation.
+func julianDayNumber(year, month, day int) int64 {
+	a := int64((14 - month) / 12)
+	y := int64(year) + 4800 - a
+	m := int64(month) + 12*a - 3
+
+	return int64(day) + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// gregorianFromJDN is the inverse of julianDayNumber: it converts a Julian Day Number
+// back into a proleptic Gregorian calendar date.
+func gregorianFromJDN(jdn int64) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - (146097*b)/4
+	d := (4*c + 3) / 1461
+	e := c - (1461*d)/4
+	m := (5*e + 2) / 153
+
+	day64 := e - (153*m+2)/5 + 1
+	month64 := m + 3 - 12*(m/10)
+	year64 := 100*b + d - 4800 + m/10
+
+	return int(year64), int(month64), int(day64)
+}
+
+// startOfISOYear returns the Julian Day Number of the Monday that starts the ISO
+// week-numbering year y, i.e. the Monday of the week containing that year's first
+// Thursday.
+func startOfISOYear(y int) int64 {
+	jan1 := julianDayNumber(y, 1, 1)
+	weekday := (jan1 % 7) + 1 // ISO weekday of Jan 1: Monday=1..Sunday=7
+
+	if weekday <= 4 {
+		return jan1 - weekday + 1
+	}
+
+	return jan1 + 8 - weekday
+}
@@ -0,0 +1,53 @@
+package week
+
+import "time"
+
+// StartTime returns the midnight (UTC) of the Monday that starts w.
+func (w *Week) StartTime() time.Time {
+	return w.Time(time.Monday)
+}
+
+// EndTime returns the midnight (UTC) of the Monday that starts the week following w,
+// i.e. the exclusive end of the half-open span [StartTime, EndTime).
+func (w *Week) EndTime() time.Time {
+	next, err := w.Add(1)
+	if err != nil {
+		// w is already the last representable week; there is no following Monday to
+		// anchor to, so fall back to exactly 7 days after StartTime.
+		return w.StartTime().AddDate(0, 0, 7)
+	}
+
+	return next.StartTime()
+}
+
+// Contains reports whether t falls within w, i.e. StartTime <= t < EndTime.
+func (w *Week) Contains(t time.Time) bool {
+	return !t.Before(w.StartTime()) && t.Before(w.EndTime())
+}
+
+// Weekday converts w to a time.Time object which represents the midnight of the
+// provided weekday. It is equivalent to Time, named to match StartTime/EndTime/Contains.
+func (w *Week) Weekday(weekday time.Weekday) time.Time {
+	return w.Time(weekday)
+}
+
+// Current returns the ISO Week containing the current moment, in UTC.
+func Current() Week {
+	return FromTime(time.Now().UTC())
+}
+
+// CurrentIn returns the ISO Week containing the current moment, evaluated in loc.
+func CurrentIn(loc *time.Location) Week {
+	return FromTime(time.Now().In(loc))
+}
+
+// NextWeekday returns the next occurrence of wd after from, advancing at least one day
+// even if from already falls on wd.
+func NextWeekday(from time.Time, wd time.Weekday) time.Time {
+	days := (int(wd) - int(from.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+
+	return from.AddDate(0, 0, days)
+}
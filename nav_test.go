@@ -0,0 +1,97 @@
+package week
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStartAndEndTime(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := w.StartTime()
+	want := time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC)
+	if !start.Equal(want) {
+		t.Errorf("StartTime() got %v want %v", start, want)
+	}
+
+	next, err := w.Add(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.EndTime().Equal(next.StartTime()) {
+		t.Errorf("EndTime() got %v want %v", w.EndTime(), next.StartTime())
+	}
+}
+
+func TestWeekContains(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !w.Contains(w.StartTime()) {
+		t.Error("expected Contains(StartTime()) true")
+	}
+	if w.Contains(w.EndTime()) {
+		t.Error("expected Contains(EndTime()) false (half-open)")
+	}
+	if !w.Contains(w.Time(time.Friday)) {
+		t.Error("expected Contains(Friday) true")
+	}
+
+	before := w.StartTime().Add(-time.Second)
+	if w.Contains(before) {
+		t.Error("expected Contains false just before StartTime")
+	}
+}
+
+func TestWeekWeekdayMatchesTime(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, wd := range []time.Weekday{time.Monday, time.Wednesday, time.Sunday} {
+		if got, want := w.Weekday(wd), w.Time(wd); !got.Equal(want) {
+			t.Errorf("Weekday(%v) got %v want %v", wd, got, want)
+		}
+	}
+}
+
+func TestCurrentAndCurrentIn(t *testing.T) {
+	want := FromTime(time.Now().UTC())
+	if got := Current(); got != want {
+		t.Errorf("Current() got %+v want %+v", got, want)
+	}
+
+	if got := CurrentIn(time.UTC); got != want {
+		t.Errorf("CurrentIn(UTC) got %+v want %+v", got, want)
+	}
+}
+
+func TestNextWeekday(t *testing.T) {
+	wed := time.Date(2024, 2, 14, 10, 0, 0, 0, time.UTC) // Wednesday
+
+	cases := []struct {
+		name string
+		wd   time.Weekday
+		want time.Time
+	}{
+		{"same weekday advances a full week", time.Wednesday, wed.AddDate(0, 0, 7)},
+		{"next day", time.Thursday, wed.AddDate(0, 0, 1)},
+		{"wraps to next week", time.Monday, wed.AddDate(0, 0, 5)},
+	}
+
+	for _, c := range cases {
+		got := NextWeekday(wed, c.wd)
+		if !got.Equal(c.want) {
+			t.Errorf("%s: got %v want %v", c.name, got, c.want)
+		}
+		if !got.After(wed) {
+			t.Errorf("%s: expected result after from", c.name)
+		}
+	}
+}
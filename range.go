@@ -0,0 +1,256 @@
+package week
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Range represents an inclusive span of ISO weeks, from Start through End.
+type Range struct {
+	Start Week
+	End   Week
+}
+
+// NewRange creates a new Range spanning a and b, inclusive. a and b may be given in
+// either order; the earlier of the two becomes Start.
+func NewRange(a, b Week) (Range, error) {
+	if a.Sub(&b) < 0 {
+		a, b = b, a
+	}
+
+	return Range{Start: a, End: b}, nil
+}
+
+// Len returns the number of weeks in r, inclusive of both endpoints.
+func (r Range) Len() int {
+	return r.Start.Sub(&r.End) + 1
+}
+
+// Contains reports whether w falls within r, inclusive of both endpoints.
+func (r Range) Contains(w Week) bool {
+	return r.Start.Sub(&w) >= 0 && w.Sub(&r.End) >= 0
+}
+
+// Overlaps reports whether r and o share at least one week.
+func (r Range) Overlaps(o Range) bool {
+	return r.Start.Sub(&o.End) >= 0 && o.Start.Sub(&r.End) >= 0
+}
+
+// Intersect returns the overlapping span of r and o. The second return value is false
+// if r and o do not overlap.
+func (r Range) Intersect(o Range) (Range, bool) {
+	if !r.Overlaps(o) {
+		return Range{}, false
+	}
+
+	start := r.Start
+	if start.Sub(&o.Start) > 0 {
+		start = o.Start
+	}
+
+	end := r.End
+	if o.End.Sub(&end) > 0 {
+		end = o.End
+	}
+
+	return Range{Start: start, End: end}, true
+}
+
+// Union returns the combined span of r and o. The second return value is false if r and
+// o neither overlap nor touch, since their union would not be a contiguous Range.
+func (r Range) Union(o Range) (Range, bool) {
+	if !r.Overlaps(o) && !adjacent(r, o) && !adjacent(o, r) {
+		return Range{}, false
+	}
+
+	start := r.Start
+	if o.Start.Sub(&start) > 0 {
+		start = o.Start
+	}
+
+	end := r.End
+	if end.Sub(&o.End) > 0 {
+		end = o.End
+	}
+
+	return Range{Start: start, End: end}, true
+}
+
+// adjacent reports whether b begins exactly one week after a ends.
+func adjacent(a, b Range) bool {
+	next, err := a.End.Next()
+	if err != nil {
+		return false
+	}
+	return next.Sub(&b.Start) == 0
+}
+
+// Split divides r into n consecutive sub-ranges of roughly equal length. The first
+// sub-ranges absorb any remainder so that every week in r is covered exactly once.
+func (r Range) Split(n int) []Range {
+	if n <= 0 {
+		return nil
+	}
+
+	total := r.Len()
+	if n > total {
+		n = total
+	}
+
+	base := total / n
+	remainder := total % n
+
+	ranges := make([]Range, 0, n)
+	cursor := r.Start
+
+	for i := 0; i < n; i++ {
+		size := base
+		if i < remainder {
+			size++
+		}
+
+		end, err := cursor.Add(size - 1)
+		if err != nil {
+			end = r.End
+		}
+
+		ranges = append(ranges, Range{Start: cursor, End: end})
+
+		next, err := end.Add(1)
+		if err != nil {
+			break
+		}
+		cursor = next
+	}
+
+	return ranges
+}
+
+// Each calls fn for every week in r, in order from Start to End, stopping early if fn
+// returns false.
+func (r Range) Each(fn func(Week) bool) {
+	for w := r.Start; ; {
+		if !fn(w) {
+			return
+		}
+
+		if w.Sub(&r.End) == 0 {
+			return
+		}
+
+		next, err := w.Add(1)
+		if err != nil {
+			return
+		}
+		w = next
+	}
+}
+
+// Iter returns a pull-style iterator over the weeks in r, from Start to End inclusive.
+// Each call returns the next week and true, or a zero Week and false once End has been
+// consumed.
+func (r Range) Iter() func() (Week, bool) {
+	current := r.Start
+	done := false
+
+	return func() (Week, bool) {
+		if done {
+			return Week{}, false
+		}
+
+		w := current
+
+		if w.Sub(&r.End) == 0 {
+			done = true
+		} else {
+			next, err := current.Add(1)
+			if err != nil {
+				done = true
+			} else {
+				current = next
+			}
+		}
+
+		return w, true
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Range.
+func (r *Range) UnmarshalJSON(data []byte) error {
+
+	if data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("unable to unmarshal json: string literal expected")
+	}
+
+	if err := r.UnmarshalText(data[1 : len(data)-1]); err != nil {
+		return errors.Wrap(err, "unable to unmarshal json")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Range.
+func (r Range) MarshalJSON() ([]byte, error) {
+
+	raw, err := r.MarshalText()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal json")
+	}
+
+	json := make([]byte, 0, len(raw)+2)
+
+	json = append(json, '"')
+	json = append(json, raw...)
+	json = append(json, '"')
+
+	return json, nil
+}
+
+// UnmarshalText implements TextUnmarshaler for Range, accepting the ISO interval syntax
+// "YYYY-Www/YYYY-Www".
+func (r *Range) UnmarshalText(data []byte) error {
+
+	if len(data) != shortFormLen*2+1 || data[shortFormLen] != '/' {
+		return errors.Errorf("invalid ISO week range %q: expected \"<week>/<week>\"", data)
+	}
+
+	var start, end Week
+
+	if err := start.UnmarshalText(data[:shortFormLen]); err != nil {
+		return errors.Wrap(err, "unable to unmarshal text: start")
+	}
+
+	if err := end.UnmarshalText(data[shortFormLen+1:]); err != nil {
+		return errors.Wrap(err, "unable to unmarshal text: end")
+	}
+
+	rng, err := NewRange(start, end)
+	if err != nil {
+		return errors.Wrap(err, "unable to unmarshal text")
+	}
+
+	*r = rng
+
+	return nil
+}
+
+// MarshalText implements TextMarshaler for Range, rendering the ISO interval syntax
+// "YYYY-Www/YYYY-Www".
+func (r Range) MarshalText() ([]byte, error) {
+
+	start, err := r.Start.MarshalText()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal text: start")
+	}
+
+	end, err := r.End.MarshalText()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal text: end")
+	}
+
+	text := make([]byte, 0, len(start)+len(end)+1)
+	text = append(text, start...)
+	text = append(text, '/')
+	text = append(text, end...)
+
+	return text, nil
+}
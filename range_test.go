@@ -0,0 +1,258 @@
+package week
+
+import "testing"
+
+func mustWeek(t *testing.T, year, week int) Week {
+	t.Helper()
+	w, err := New(year, week)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestNewRangeOrdersEndpoints(t *testing.T) {
+	a := mustWeek(t, 2024, 10)
+	b := mustWeek(t, 2024, 1)
+
+	r, err := NewRange(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Start != b || r.End != a {
+		t.Errorf("got [%+v,%+v] want [%+v,%+v]", r.Start, r.End, b, a)
+	}
+}
+
+func TestRangeLen(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Len(); got != 10 {
+		t.Errorf("Len() got %d want 10", got)
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 3), mustWeek(t, 2024, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		week int
+		want bool
+	}{
+		{2, false},
+		{3, true},
+		{5, true},
+		{7, true},
+		{8, false},
+	}
+
+	for _, c := range cases {
+		if got := r.Contains(mustWeek(t, 2024, c.week)); got != c.want {
+			t.Errorf("Contains(week %d) got %v want %v", c.week, got, c.want)
+		}
+	}
+}
+
+func TestRangeOverlaps(t *testing.T) {
+	r := Range{Start: mustWeek(t, 2024, 3), End: mustWeek(t, 2024, 7)}
+
+	cases := []struct {
+		name       string
+		start, end int
+		want       bool
+	}{
+		{"fully before", 1, 2, false},
+		{"touching before", 1, 3, true},
+		{"overlapping start", 1, 5, true},
+		{"contained", 4, 5, true},
+		{"overlapping end", 5, 9, true},
+		{"touching after", 7, 9, true},
+		{"fully after", 8, 9, false},
+	}
+
+	for _, c := range cases {
+		o := Range{Start: mustWeek(t, 2024, c.start), End: mustWeek(t, 2024, c.end)}
+		if got := r.Overlaps(o); got != c.want {
+			t.Errorf("%s: Overlaps got %v want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRangeIntersect(t *testing.T) {
+	r := Range{Start: mustWeek(t, 2024, 3), End: mustWeek(t, 2024, 7)}
+	o := Range{Start: mustWeek(t, 2024, 5), End: mustWeek(t, 2024, 9)}
+
+	got, ok := r.Intersect(o)
+	if !ok {
+		t.Fatal("expected intersection")
+	}
+	want := Range{Start: mustWeek(t, 2024, 5), End: mustWeek(t, 2024, 7)}
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	disjoint := Range{Start: mustWeek(t, 2024, 20), End: mustWeek(t, 2024, 25)}
+	if _, ok := r.Intersect(disjoint); ok {
+		t.Error("expected no intersection for disjoint ranges")
+	}
+}
+
+func TestRangeUnion(t *testing.T) {
+	r := Range{Start: mustWeek(t, 2024, 1), End: mustWeek(t, 2024, 5)}
+
+	overlapping := Range{Start: mustWeek(t, 2024, 3), End: mustWeek(t, 2024, 8)}
+	got, ok := r.Union(overlapping)
+	if !ok {
+		t.Fatal("expected union for overlapping ranges")
+	}
+	want := Range{Start: mustWeek(t, 2024, 1), End: mustWeek(t, 2024, 8)}
+	if got != want {
+		t.Errorf("overlapping union got %+v want %+v", got, want)
+	}
+
+	adjacent := Range{Start: mustWeek(t, 2024, 6), End: mustWeek(t, 2024, 9)}
+	got, ok = r.Union(adjacent)
+	if !ok {
+		t.Fatal("expected union for adjacent ranges")
+	}
+	want = Range{Start: mustWeek(t, 2024, 1), End: mustWeek(t, 2024, 9)}
+	if got != want {
+		t.Errorf("adjacent union got %+v want %+v", got, want)
+	}
+
+	disjoint := Range{Start: mustWeek(t, 2024, 20), End: mustWeek(t, 2024, 25)}
+	if _, ok := r.Union(disjoint); ok {
+		t.Error("expected no union for non-adjacent disjoint ranges")
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := r.Split(3)
+	if len(parts) != 3 {
+		t.Fatalf("got %d parts, want 3", len(parts))
+	}
+
+	total := 0
+	for i, p := range parts {
+		total += p.Len()
+		if i > 0 {
+			next, err := parts[i-1].End.Add(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if next != p.Start {
+				t.Errorf("part %d is not contiguous with part %d", i, i-1)
+			}
+		}
+	}
+	if total != r.Len() {
+		t.Errorf("split total got %d want %d", total, r.Len())
+	}
+	if parts[len(parts)-1].End != r.End {
+		t.Errorf("last part end got %+v want %+v", parts[len(parts)-1].End, r.End)
+	}
+}
+
+func TestRangeEachAndIter(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var visited []Week
+	r.Each(func(w Week) bool {
+		visited = append(visited, w)
+		return true
+	})
+	if len(visited) != r.Len() {
+		t.Fatalf("Each visited %d weeks, want %d", len(visited), r.Len())
+	}
+
+	next := r.Iter()
+	for i, want := range visited {
+		got, ok := next()
+		if !ok {
+			t.Fatalf("Iter() stopped early at index %d", i)
+		}
+		if got != want {
+			t.Errorf("Iter()[%d] got %+v want %+v", i, got, want)
+		}
+	}
+	if _, ok := next(); ok {
+		t.Error("expected Iter() to be exhausted")
+	}
+}
+
+func TestRangeEachStopsEarly(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	r.Each(func(Week) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("got %d visits, want 2", count)
+	}
+}
+
+func TestRangeMarshalText(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text, err := r.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "2024-W01/2024-W10"
+	if string(text) != want {
+		t.Errorf("MarshalText() got %q want %q", text, want)
+	}
+
+	var roundTripped Range
+	if err := roundTripped.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != r {
+		t.Errorf("round trip got %+v want %+v", roundTripped, r)
+	}
+}
+
+func TestRangeMarshalJSON(t *testing.T) {
+	r, err := NewRange(mustWeek(t, 2024, 1), mustWeek(t, 2024, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `"2024-W01/2024-W10"`
+	if string(data) != want {
+		t.Errorf("MarshalJSON() got %s want %s", data, want)
+	}
+
+	var roundTripped Range
+	if err := roundTripped.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != r {
+		t.Errorf("round trip got %+v want %+v", roundTripped, r)
+	}
+}
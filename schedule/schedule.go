@@ -0,0 +1,285 @@
+// Package schedule provides a recurring weekly schedule built on top of week.Week and
+// week.Range.
+package schedule
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	week "github.com/xkizer/go-week"
+)
+
+// dayWindow is a single time-of-day window, expressed as offsets from midnight.
+type dayWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Weekly describes a recurring 7-day plan of time-of-day ranges keyed by time.Weekday.
+// The zero value is an empty schedule in UTC.
+type Weekly struct {
+	windows  map[time.Weekday]dayWindow
+	location *time.Location
+}
+
+// Set defines the active time-of-day window for day, replacing any window previously
+// set for that day. start and end are offsets from midnight.
+func (w *Weekly) Set(day time.Weekday, start, end time.Duration) {
+	if w.windows == nil {
+		w.windows = make(map[time.Weekday]dayWindow)
+	}
+
+	w.windows[day] = dayWindow{Start: start, End: end}
+}
+
+// SetLocation sets the time.Location used to evaluate Contains and to walk days in
+// Plan.NextOccurrence and Plan.OccurrencesIn. A nil loc is treated as time.UTC.
+func (w *Weekly) SetLocation(loc *time.Location) {
+	w.location = loc
+}
+
+// loc returns the configured location, defaulting to UTC.
+func (w Weekly) loc() *time.Location {
+	if w.location == nil {
+		return time.UTC
+	}
+	return w.location
+}
+
+// Contains reports whether t falls within the window set for its weekday, evaluated in
+// w's configured location.
+func (w Weekly) Contains(t time.Time) bool {
+	local := t.In(w.loc())
+
+	win, ok := w.windows[local.Weekday()]
+	if !ok {
+		return false
+	}
+
+	offset := wallClockOffset(local)
+
+	return offset >= win.Start && offset < win.End
+}
+
+// wallClockOffset returns t's time-of-day as a Duration since midnight, read from its
+// wall-clock fields. Unlike subtracting a constructed midnight instant, this is immune
+// to DST transitions, where elapsed real time and wall-clock time diverge.
+func wallClockOffset(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
+// atOffset returns the instant on day's calendar date, in day's location, whose
+// wall-clock time-of-day is offset since midnight. Like wallClockOffset, this builds the
+// result from wall-clock fields rather than adding offset to a midnight instant, so it is
+// unaffected by DST transitions on day.
+func atOffset(day time.Time, offset time.Duration) time.Time {
+	h := int(offset / time.Hour)
+	offset -= time.Duration(h) * time.Hour
+
+	m := int(offset / time.Minute)
+	offset -= time.Duration(m) * time.Minute
+
+	s := int(offset / time.Second)
+	ns := int(offset - time.Duration(s)*time.Second)
+
+	return time.Date(day.Year(), day.Month(), day.Day(), h, m, s, ns, day.Location())
+}
+
+// weeklyJSON is the wire representation of one day's window within Weekly's JSON map,
+// which is keyed by weekday name.
+type weeklyJSON struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// MarshalJSON implements json.Marshaler for Weekly, encoding it as a map keyed by
+// weekday name (Monday..Sunday).
+func (w Weekly) MarshalJSON() ([]byte, error) {
+	out := make(map[string]weeklyJSON, len(w.windows))
+
+	for day, win := range w.windows {
+		out[day.String()] = weeklyJSON{Start: win.Start.String(), End: win.End.String()}
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Weekly.
+func (w *Weekly) UnmarshalJSON(data []byte) error {
+	var raw map[string]weeklyJSON
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errors.Wrap(err, "unable to unmarshal json")
+	}
+
+	windows := make(map[time.Weekday]dayWindow, len(raw))
+
+	for name, win := range raw {
+		day, ok := weekdayByName[name]
+		if !ok {
+			return errors.Errorf("unable to unmarshal json: unknown weekday %q", name)
+		}
+
+		start, err := time.ParseDuration(win.Start)
+		if err != nil {
+			return errors.Wrapf(err, "unable to unmarshal json: start of %s", name)
+		}
+
+		end, err := time.ParseDuration(win.End)
+		if err != nil {
+			return errors.Wrapf(err, "unable to unmarshal json: end of %s", name)
+		}
+
+		windows[day] = dayWindow{Start: start, End: end}
+	}
+
+	w.windows = windows
+
+	return nil
+}
+
+// weekdayByName maps weekday names, as produced by time.Weekday.String, back to their
+// time.Weekday value.
+var weekdayByName = map[string]time.Weekday{
+	time.Sunday.String():    time.Sunday,
+	time.Monday.String():    time.Monday,
+	time.Tuesday.String():   time.Tuesday,
+	time.Wednesday.String(): time.Wednesday,
+	time.Thursday.String():  time.Thursday,
+	time.Friday.String():    time.Friday,
+	time.Saturday.String():  time.Saturday,
+}
+
+// Plan attaches a Weekly to a Range of ISO weeks, with optional exceptions for specific
+// weeks that should be skipped entirely.
+type Plan struct {
+	Range      week.Range
+	Weekly     Weekly
+	Exceptions []week.Week
+}
+
+// isException reports whether w is one of p's excepted weeks.
+func (p *Plan) isException(w week.Week) bool {
+	for _, e := range p.Exceptions {
+		if e.Sub(&w) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// bounds returns the half-open [start, end) time span covered by p.Range, in p.Weekly's
+// configured location. The boundary instants are built from the boundary weeks' calendar
+// dates directly, rather than by re-zoning a UTC instant, so they land on local midnight
+// regardless of location.
+func (p *Plan) bounds() (start, end time.Time, err error) {
+	loc := p.Weekly.loc()
+
+	y, m, d := p.Range.Start.Time(time.Monday).Date()
+	start = time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	afterRange, err := p.Range.End.Add(1)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Wrap(err, "unable to compute range bounds")
+	}
+
+	y, m, d = afterRange.Time(time.Monday).Date()
+	end = time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	return start, end, nil
+}
+
+// NextOccurrence returns the start time of the next scheduled window strictly after
+// after, within p.Range and not excepted. The second return value is false once there
+// are no more occurrences.
+func (p *Plan) NextOccurrence(after time.Time) (time.Time, bool) {
+	start, end, err := p.bounds()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	loc := p.Weekly.loc()
+	cursor := after.In(loc)
+
+	day := time.Date(cursor.Year(), cursor.Month(), cursor.Day(), 0, 0, 0, 0, loc)
+	if day.Before(start) {
+		day = start
+	}
+
+	for day.Before(end) {
+		if !p.isException(week.FromTime(day)) {
+			if win, ok := p.Weekly.windows[day.Weekday()]; ok {
+				occurrence := atOffset(day, win.Start)
+				if occurrence.After(cursor) {
+					return occurrence, true
+				}
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+// OccurrencesIn calls fn for the start time of every scheduled window within r that is
+// not excepted, in order, stopping early if fn returns false.
+func (p *Plan) OccurrencesIn(r week.Range, fn func(time.Time) bool) {
+	bounded := Plan{Range: r, Weekly: p.Weekly, Exceptions: p.Exceptions}
+
+	start, end, err := bounded.bounds()
+	if err != nil {
+		return
+	}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if bounded.isException(week.FromTime(day)) {
+			continue
+		}
+
+		win, ok := p.Weekly.windows[day.Weekday()]
+		if !ok {
+			continue
+		}
+
+		if !fn(atOffset(day, win.Start)) {
+			return
+		}
+	}
+}
+
+// planJSON is the wire representation of Plan.
+type planJSON struct {
+	Range      week.Range  `json:"range"`
+	Weekly     Weekly      `json:"weekly"`
+	Exceptions []week.Week `json:"exceptions"`
+}
+
+// MarshalJSON implements json.Marshaler for Plan.
+func (p Plan) MarshalJSON() ([]byte, error) {
+	raw, err := json.Marshal(planJSON{Range: p.Range, Weekly: p.Weekly, Exceptions: p.Exceptions})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal json")
+	}
+
+	return raw, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Plan.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	var raw planJSON
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errors.Wrap(err, "unable to unmarshal json")
+	}
+
+	p.Range = raw.Range
+	p.Weekly = raw.Weekly
+	p.Exceptions = raw.Exceptions
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	week "github.com/xkizer/go-week"
+)
+
+func TestWeeklyContains(t *testing.T) {
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+
+	cases := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{"before window", time.Date(2024, 2, 12, 8, 59, 0, 0, time.UTC), false},
+		{"at start", time.Date(2024, 2, 12, 9, 0, 0, 0, time.UTC), true},
+		{"inside window", time.Date(2024, 2, 12, 12, 0, 0, 0, time.UTC), true},
+		{"at end (exclusive)", time.Date(2024, 2, 12, 17, 0, 0, 0, time.UTC), false},
+		{"wrong weekday", time.Date(2024, 2, 13, 12, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, c := range cases {
+		if got := wk.Contains(c.t); got != c.want {
+			t.Errorf("%s: Contains(%v) got %v want %v", c.name, c.t, got, c.want)
+		}
+	}
+}
+
+func TestWeeklyContainsAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available in this environment")
+	}
+
+	var wk Weekly
+	wk.SetLocation(loc)
+	wk.Set(time.Sunday, 9*time.Hour, 17*time.Hour)
+
+	// 2024-03-10 is the US spring-forward day: 02:00 local jumps to 03:00.
+	cases := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{"just before end", time.Date(2024, 3, 10, 16, 30, 0, 0, loc), true},
+		{"at end", time.Date(2024, 3, 10, 17, 0, 0, 0, loc), false},
+		{"just after end", time.Date(2024, 3, 10, 17, 30, 0, 0, loc), false},
+	}
+
+	for _, c := range cases {
+		if got := wk.Contains(c.t); got != c.want {
+			t.Errorf("%s: Contains(%v) got %v want %v", c.name, c.t, got, c.want)
+		}
+	}
+}
+
+func TestWeeklyMarshalJSON(t *testing.T) {
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+
+	data, err := wk.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roundTripped Weekly
+	if err := roundTripped.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+
+	probe := time.Date(2024, 2, 12, 12, 0, 0, 0, time.UTC) // Monday
+	if !roundTripped.Contains(probe) {
+		t.Error("expected round-tripped Weekly to contain the same window")
+	}
+}
+
+func TestPlanNextOccurrence(t *testing.T) {
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+	wk.Set(time.Wednesday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk}
+
+	after := time.Date(2024, 2, 12, 10, 0, 0, 0, time.UTC) // Monday, inside window
+	got, ok := p.NextOccurrence(after)
+	if !ok {
+		t.Fatal("expected an occurrence")
+	}
+	want := time.Date(2024, 2, 14, 9, 0, 0, 0, time.UTC) // Wednesday 09:00
+	if !got.Equal(want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestPlanNextOccurrenceSkipsExceptions(t *testing.T) {
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	excepted, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk, Exceptions: []week.Week{excepted}}
+
+	after := time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC) // Monday of week 7, excepted
+	got, ok := p.NextOccurrence(after)
+	if !ok {
+		t.Fatal("expected an occurrence")
+	}
+	want := time.Date(2024, 2, 19, 9, 0, 0, 0, time.UTC) // Monday of week 8
+	if !got.Equal(want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestPlanOccurrencesIn(t *testing.T) {
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk}
+
+	var got []time.Time
+	p.OccurrencesIn(r, func(occurrence time.Time) bool {
+		got = append(got, occurrence)
+		return true
+	})
+
+	want := []time.Time{
+		time.Date(2024, 2, 12, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 19, 9, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d occurrences, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d got %v want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPlanOccurrencesInNonUTCLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available in this environment")
+	}
+
+	// ISO weeks 2024-W07/2024-W08: Monday 2024-02-12 through Sunday 2024-02-25.
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.SetLocation(loc)
+	wk.Set(time.Sunday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk}
+
+	var got []time.Time
+	p.OccurrencesIn(r, func(occurrence time.Time) bool {
+		got = append(got, occurrence)
+		return true
+	})
+
+	want := []time.Time{
+		time.Date(2024, 2, 18, 9, 0, 0, 0, loc),
+		time.Date(2024, 2, 25, 9, 0, 0, 0, loc),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d occurrences, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("occurrence %d got %v want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPlanNextOccurrenceNonUTCLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available in this environment")
+	}
+
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.SetLocation(loc)
+	wk.Set(time.Sunday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk}
+
+	after := time.Date(2024, 2, 12, 0, 0, 0, 0, loc) // Monday, start of range
+	got, ok := p.NextOccurrence(after)
+	if !ok {
+		t.Fatal("expected an occurrence")
+	}
+	want := time.Date(2024, 2, 18, 9, 0, 0, 0, loc)
+	if !got.Equal(want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestPlanOccurrencesInStopsEarly(t *testing.T) {
+	start, err := week.New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := week.New(2024, 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := week.NewRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wk Weekly
+	wk.Set(time.Monday, 9*time.Hour, 17*time.Hour)
+
+	p := Plan{Range: r, Weekly: wk}
+
+	count := 0
+	p.OccurrencesIn(r, func(time.Time) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("got %d occurrences, want 1", count)
+	}
+}
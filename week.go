@@ -3,7 +3,6 @@ package week
 
 import (
 	"database/sql/driver"
-	"math"
 	"time"
 
 	"github.com/pkg/errors"
@@ -56,46 +55,10 @@ func (w *Week) Add(weeks int) (Week, error) {
 
 // Sub calculates the positive difference between w and u (u-w) in number of weeks
 func (w *Week) Sub(u *Week) int {
-	var (
-		diff        = 0
-		yearDiff    = u.year - w.year
-		direction   = 1
-		smallerWeek = w
-		biggerWeek  = u
-	)
-
-	if yearDiff != 0 {
-		direction = int(math.Sqrt(float64(yearDiff*yearDiff))) / yearDiff
-	}
-
-	if direction == -1 {
-		smallerWeek = u
-		biggerWeek = w
-	}
-
-	var (
-		yearA = smallerWeek.year
-		yearB = biggerWeek.year
-		weekA = smallerWeek.week
-		weekB = biggerWeek.week
-	)
-
-	for {
-		if yearA > yearB {
-			panic("infinite loop guard: yearA should never be more than yearB")
-		}
-
-		if yearA != yearB {
-			diff += weeksInYear(yearA)
-			yearA++
-			continue
-		}
+	wStart := startOfISOYear(w.year) + 7*int64(w.week-1)
+	uStart := startOfISOYear(u.year) + 7*int64(u.week-1)
 
-		diff += weekB - weekA
-		break
-	}
-
-	return diff * direction
+	return int((uStart - wStart) / 7)
 }
 
 func (w *Week) add(weeksToAdd int) (Week, error) {
@@ -142,7 +105,7 @@ func (w *Week) UnmarshalJSON(data []byte) error {
 		return errors.New("unable to unmarshal json: string literal expected")
 	}
 
-	year, week, err := decodeISOWeekDate(data[1 : len(data)-1])
+	year, week, _, err := decodeISOWeekDate(data[1 : len(data)-1])
 	if err != nil {
 		return errors.Wrap(err, "unable to unmarshal json")
 	}
@@ -155,7 +118,7 @@ func (w *Week) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements json.Marshaler for Week.
 func (w Week) MarshalJSON() ([]byte, error) {
 
-	raw, err := encodeISOWeekDate(w.year, w.week)
+	raw, err := encodeISOWeekDate(w.year, w.week, 0)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to marshal json")
 	}
@@ -172,7 +135,7 @@ func (w Week) MarshalJSON() ([]byte, error) {
 // UnmarshalText implements TextUnmarshaler for Week.
 func (w *Week) UnmarshalText(data []byte) error {
 
-	year, week, err := decodeISOWeekDate(data)
+	year, week, _, err := decodeISOWeekDate(data)
 	if err != nil {
 		return errors.Wrap(err, "unable to unmarshal text")
 	}
@@ -185,7 +148,7 @@ func (w *Week) UnmarshalText(data []byte) error {
 // MarshalText implements TextMarshaler for Week.
 func (w Week) MarshalText() ([]byte, error) {
 
-	text, err := encodeISOWeekDate(w.year, w.week)
+	text, err := encodeISOWeekDate(w.year, w.week, 0)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to marshal text")
 	}
@@ -196,7 +159,7 @@ func (w Week) MarshalText() ([]byte, error) {
 // Value implements Valuer for Week.
 func (w Week) Value() (driver.Value, error) {
 
-	text, err := encodeISOWeekDate(w.year, w.week)
+	text, err := encodeISOWeekDate(w.year, w.week, 0)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create value")
 	}
@@ -213,9 +176,9 @@ func (w *Week) Scan(src interface{}) error {
 
 	switch val := src.(type) {
 	case string:
-		year, week, err = decodeISOWeekDate([]byte(val))
+		year, week, _, err = decodeISOWeekDate([]byte(val))
 	case []byte:
-		year, week, err = decodeISOWeekDate(val)
+		year, week, _, err = decodeISOWeekDate(val)
 	default:
 		return errors.New("unable to scan value: incompatible type")
 	}
@@ -237,37 +200,16 @@ func FromTime(t time.Time) Week {
 
 // Time converts a week to a time.Time object which represents the midnight of the provided weekday.
 func (w *Week) Time(weekday time.Weekday) time.Time {
-	// The implementation based on the method on the ordinal day of the year and described here:
-	// https://en.wikipedia.org/wiki/ISO_week_date#Calculating_a_date_given_the_year,_week_number_and_weekday
 	isoWeekday := convertToISOWeekday(weekday)
-	jan4th := time.Date(w.Year(), 1, 4, 0, 0, 0, 0, time.UTC)
-	correction := convertToISOWeekday(jan4th.Weekday()) + 3
+	jdn := startOfISOYear(w.Year()) + 7*int64(w.Week()-1) + int64(isoWeekday-1)
+	year, month, day := gregorianFromJDN(jdn)
 
-	ordinal := w.Week()*7 + isoWeekday - correction
-	year, ordinal := normalizeOrdinal(w.Year(), ordinal)
-
-	return time.Date(year, 1, ordinal, 0, 0, 0, 0, time.UTC)
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
 
-// normalizeOrdinal checks if ordinal number is in range between 1 and actual number of days
-// in the specified year. If its our of this range, values for the year and ordinal date
-// are adjusted
-func normalizeOrdinal(year, ordinal int) (normalizedYear, normalizedOrdinal int) {
-	daysInYear := 365
-	if ordinal < 1 {
-		if isLeapYear(year - 1) {
-			daysInYear = 366
-		}
-		return year - 1, daysInYear + ordinal
-	}
-
-	if isLeapYear(year) {
-		daysInYear = 366
-	}
-	if ordinal > daysInYear {
-		return year + 1, ordinal - daysInYear
-	}
-	return year, ordinal
+// On returns the WeekDate identifying the given weekday within w.
+func (w *Week) On(weekday time.Weekday) WeekDate {
+	return WeekDate{week: *w, weekday: convertToISOWeekday(weekday)}
 }
 
 // convertToISOWeekday convert time.Weekday value to an ISO representation of weekday which declares
@@ -0,0 +1,27 @@
+package week
+
+import "testing"
+
+// BenchmarkWeekSub exercises Sub across the full supported year range, where the
+// previous year-by-year loop implementation degraded linearly with the distance between
+// the two weeks.
+func BenchmarkWeekSub(b *testing.B) {
+	w := Week{year: 0, week: 1}
+	u := Week{year: 9999, week: 52}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w.Sub(&u)
+	}
+}
+
+// BenchmarkWeekTime exercises Time for a week far from the epoch used by the previous
+// ordinal-based implementation.
+func BenchmarkWeekTime(b *testing.B) {
+	w := Week{year: 9999, week: 52}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w.Time(1) // time.Monday
+	}
+}
@@ -0,0 +1,145 @@
+package week
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WeekDate represents an ISO 8601 week date with an explicit weekday component, e.g.
+// "2024-W07-3" (the extended form). Unlike Week, which identifies only a year and week
+// number, a WeekDate identifies a single calendar day.
+type WeekDate struct {
+	week    Week
+	weekday int // ISO weekday: Monday=1 .. Sunday=7
+}
+
+// Week returns the Week component of d.
+func (d WeekDate) Week() Week {
+	return d.week
+}
+
+// Weekday returns the ISO weekday (Monday=1..Sunday=7) component of d.
+func (d WeekDate) Weekday() int {
+	return d.weekday
+}
+
+// Time converts d to the time.Time midnight (UTC) of the day it identifies.
+func (d WeekDate) Time() time.Time {
+	return d.week.Time(isoWeekdayToTime(d.weekday))
+}
+
+// isoWeekdayToTime converts an ISO weekday (Monday=1..Sunday=7) to the corresponding
+// time.Weekday (Sunday=0..Saturday=6).
+func isoWeekdayToTime(weekday int) time.Weekday {
+	if weekday == 7 {
+		return time.Sunday
+	}
+	return time.Weekday(weekday)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for WeekDate.
+func (d *WeekDate) UnmarshalJSON(data []byte) error {
+
+	if data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("unable to unmarshal json: string literal expected")
+	}
+
+	year, week, weekday, err := decodeISOWeekDate(data[1 : len(data)-1])
+	if err != nil {
+		return errors.Wrap(err, "unable to unmarshal json")
+	}
+	if weekday == 0 {
+		return errors.New("unable to unmarshal json: weekday component required for WeekDate")
+	}
+
+	d.week = Week{year: year, week: week}
+	d.weekday = weekday
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for WeekDate.
+func (d WeekDate) MarshalJSON() ([]byte, error) {
+
+	raw, err := encodeISOWeekDate(d.week.year, d.week.week, d.weekday)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal json")
+	}
+
+	json := make([]byte, 0, len(raw)+2)
+
+	json = append(json, '"')
+	json = append(json, raw...)
+	json = append(json, '"')
+
+	return json, nil
+}
+
+// UnmarshalText implements TextUnmarshaler for WeekDate.
+func (d *WeekDate) UnmarshalText(data []byte) error {
+
+	year, week, weekday, err := decodeISOWeekDate(data)
+	if err != nil {
+		return errors.Wrap(err, "unable to unmarshal text")
+	}
+	if weekday == 0 {
+		return errors.New("unable to unmarshal text: weekday component required for WeekDate")
+	}
+
+	d.week = Week{year: year, week: week}
+	d.weekday = weekday
+
+	return nil
+}
+
+// MarshalText implements TextMarshaler for WeekDate.
+func (d WeekDate) MarshalText() ([]byte, error) {
+
+	text, err := encodeISOWeekDate(d.week.year, d.week.week, d.weekday)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal text")
+	}
+
+	return text, nil
+}
+
+// Value implements Valuer for WeekDate.
+func (d WeekDate) Value() (driver.Value, error) {
+
+	text, err := encodeISOWeekDate(d.week.year, d.week.week, d.weekday)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create value")
+	}
+
+	return driver.Value(text), nil
+}
+
+// Scan implements scanner for WeekDate.
+func (d *WeekDate) Scan(src interface{}) error {
+
+	var data []byte
+
+	switch val := src.(type) {
+	case string:
+		data = []byte(val)
+	case []byte:
+		data = val
+	default:
+		return errors.New("unable to scan value: incompatible type")
+	}
+
+	year, week, weekday, err := decodeISOWeekDate(data)
+	if err != nil {
+		return errors.Wrap(err, "unable to scan value")
+	}
+	if weekday == 0 {
+		return errors.New("unable to scan value: weekday component required for WeekDate")
+	}
+
+	d.week = Week{year: year, week: week}
+	d.weekday = weekday
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package week
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekOn(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd := w.On(time.Wednesday)
+	if wd.Week() != w {
+		t.Errorf("Week() got %+v want %+v", wd.Week(), w)
+	}
+	if wd.Weekday() != 3 {
+		t.Errorf("Weekday() got %d want 3", wd.Weekday())
+	}
+}
+
+func TestWeekDateTime(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd := w.On(time.Wednesday)
+	want := w.Time(time.Wednesday)
+	if !wd.Time().Equal(want) {
+		t.Errorf("Time() got %v want %v", wd.Time(), want)
+	}
+}
+
+func TestWeekDateMarshalText(t *testing.T) {
+	cases := []struct {
+		year, week, weekday int
+		want                string
+	}{
+		{2024, 7, 1, "2024-W07-1"},
+		{2024, 7, 7, "2024-W07-7"},
+		{1, 1, 1, "0001-W01-1"},
+	}
+
+	for _, c := range cases {
+		w, err := New(c.year, c.week)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wd := w.On(isoWeekdayToTime(c.weekday))
+
+		got, err := wd.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != c.want {
+			t.Errorf("MarshalText() got %q want %q", got, c.want)
+		}
+
+		var roundTripped WeekDate
+		if err := roundTripped.UnmarshalText(got); err != nil {
+			t.Fatal(err)
+		}
+		if roundTripped != wd {
+			t.Errorf("round trip got %+v want %+v", roundTripped, wd)
+		}
+	}
+}
+
+func TestWeekDateMarshalJSON(t *testing.T) {
+	w, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd := w.On(time.Monday)
+
+	data, err := wd.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"2024-W07-1"` {
+		t.Errorf("MarshalJSON() got %s want %q", data, `"2024-W07-1"`)
+	}
+
+	var roundTripped WeekDate
+	if err := roundTripped.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if roundTripped != wd {
+		t.Errorf("round trip got %+v want %+v", roundTripped, wd)
+	}
+}
+
+func TestWeekDateUnmarshalTextRejectsShortForm(t *testing.T) {
+	var wd WeekDate
+	if err := wd.UnmarshalText([]byte("2024-W07")); err == nil {
+		t.Error("expected error unmarshaling short form into WeekDate")
+	}
+}
+
+func TestWeekUnmarshalTextAcceptsExtendedForm(t *testing.T) {
+	var w Week
+	if err := w.UnmarshalText([]byte("2024-W07-3")); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := New(2024, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w != want {
+		t.Errorf("got %+v want %+v", w, want)
+	}
+}
+
+func TestDecodeISOWeekDateRejectsUnknownShapes(t *testing.T) {
+	cases := []string{
+		"",
+		"2024-W7",
+		"2024W073",
+		"2024-W07-",
+		"2024-W07-8",
+		"2024-W07-0",
+		"2024/W07",
+	}
+
+	for _, c := range cases {
+		if _, _, _, err := decodeISOWeekDate([]byte(c)); err == nil {
+			t.Errorf("decodeISOWeekDate(%q) expected error, got none", c)
+		}
+	}
+}